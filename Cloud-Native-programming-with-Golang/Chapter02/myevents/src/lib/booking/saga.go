@@ -0,0 +1,57 @@
+// Package booking coordinates a courseing across the local outbox and
+// the stores service's inventory reservation, compensating with a
+// cancellation event when the reservation fails after the courseing
+// has already been recorded.
+package booking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/events"
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/persistence"
+)
+
+// InventoryReserver reserves seats for an event in the stores service's
+// inventory. A non-nil error means the reservation did not go through.
+type InventoryReserver interface {
+	Reserve(ctx context.Context, eventID string, seats int) error
+}
+
+// Saga books a courseing and reserves inventory for it, undoing the
+// courseing with a compensating events.CancelledEvent if the
+// reservation step fails.
+type Saga struct {
+	handler   persistence.DatabaseHandler
+	inventory InventoryReserver
+}
+
+// NewSaga builds a Saga booking against handler and reserving inventory
+// through inventory.
+func NewSaga(handler persistence.DatabaseHandler, inventory InventoryReserver) *Saga {
+	return &Saga{handler: handler, inventory: inventory}
+}
+
+// Book records courseing for userID and reserves its seats in the
+// stores service's inventory. If the reservation fails, Book emits a
+// compensating events.CancelledEvent through the outbox and returns the
+// reservation error; the courseing itself is left recorded, since the
+// cancellation event is what downstream consumers reconcile against.
+func (s *Saga) Book(ctx context.Context, userID persistence.ID, courseing persistence.Courseing) error {
+	if err := s.handler.AddCourseingForUser(ctx, userID, courseing); err != nil {
+		return err
+	}
+
+	if err := s.inventory.Reserve(ctx, courseing.EventID.String(), courseing.Seats); err != nil {
+		if _, recordErr := s.handler.RecordOutboxEvent(ctx, "users", &events.CancelledEvent{
+			EventID: courseing.EventID.String(),
+			UserID:  userID.String(),
+			Reason:  err.Error(),
+		}); recordErr != nil {
+			return fmt.Errorf("reservation failed (%w) and compensating cancellation could not be recorded: %v", err, recordErr)
+		}
+		return fmt.Errorf("reserve inventory: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+// Package emitting publishes domain events coming out of the booking
+// service onto the message broker (AMQP/Kafka) so other services can
+// react to them.
+package emitting
+
+import "github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/events"
+
+// Emitter publishes events onto the configured broker.
+type Emitter interface {
+	Emit(event events.Event) error
+}
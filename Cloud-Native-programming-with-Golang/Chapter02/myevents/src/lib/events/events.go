@@ -0,0 +1,28 @@
+// Package events holds the domain events the booking flow emits. They
+// travel both through the existing message broker emitter and through
+// the transactional outbox, so every event also carries the type name
+// it was stored under (see persistence.OutboxEntry.EventType).
+package events
+
+// Event is implemented by every event the outbox can carry.
+type Event interface {
+	EventName() string
+}
+
+// CourseedEvent is emitted whenever a courseing is made for a user.
+type CourseedEvent struct {
+	EventID string `json:"eventId"`
+	UserID  string `json:"userId"`
+}
+
+func (c *CourseedEvent) EventName() string { return "eventCourseed" }
+
+// CancelledEvent is emitted whenever a courseing is cancelled, either by
+// the user or as a compensating action when a downstream step fails.
+type CancelledEvent struct {
+	EventID string `json:"eventId"`
+	UserID  string `json:"userId"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func (c *CancelledEvent) EventName() string { return "eventCancelled" }
@@ -0,0 +1,91 @@
+// Package outbox drains the transactional outbox persistence.DatabaseHandler
+// writes alongside business data, publishing each entry through the
+// existing emitter and marking it published. Consumers are expected to
+// be idempotent, keyed on the outbox entry's ID, since a crash between
+// publish and mark-published redelivers the same entry.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/emitting"
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/events"
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/persistence"
+)
+
+// decoders maps an OutboxEntry's EventType to a constructor for the
+// concrete events.Event it was serialized from, so the relay knows what
+// to json.Unmarshal the payload into.
+var decoders = map[string]func() events.Event{
+	(&events.CourseedEvent{}).EventName():  func() events.Event { return &events.CourseedEvent{} },
+	(&events.CancelledEvent{}).EventName(): func() events.Event { return &events.CancelledEvent{} },
+}
+
+// Relay polls the outbox for unpublished entries and publishes them
+// through Emitter.
+type Relay struct {
+	handler      persistence.DatabaseHandler
+	emitter      emitting.Emitter
+	pollInterval time.Duration
+}
+
+// NewRelay builds a Relay that polls handler every pollInterval and
+// publishes what it finds through emitter.
+func NewRelay(handler persistence.DatabaseHandler, emitter emitting.Emitter, pollInterval time.Duration) *Relay {
+	return &Relay{handler: handler, emitter: emitter, pollInterval: pollInterval}
+}
+
+// Run polls until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.drain(ctx)
+		}
+	}
+}
+
+func (r *Relay) drain(ctx context.Context) {
+	entries, err := r.handler.FindUnpublishedOutboxEntries(ctx)
+	if err != nil {
+		log.Printf("outbox: list unpublished entries: %v", err)
+		return
+	}
+
+	for _, entry := range entries {
+		if err := r.publish(ctx, entry); err != nil {
+			log.Printf("outbox: publish entry %s: %v", entry.ID, err)
+		}
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, entry persistence.OutboxEntry) error {
+	newEvent, ok := decoders[entry.EventType]
+	if !ok {
+		log.Printf("outbox: entry %s has unknown event type %q, skipping", entry.ID, entry.EventType)
+		return r.handler.MarkOutboxPublished(ctx, entry.ID, time.Now().UnixMilli())
+	}
+
+	event := newEvent()
+	if err := unmarshal(entry.Payload, event); err != nil {
+		return err
+	}
+
+	if err := r.emitter.Emit(event); err != nil {
+		return err
+	}
+
+	return r.handler.MarkOutboxPublished(ctx, entry.ID, time.Now().UnixMilli())
+}
+
+func unmarshal(payload []byte, event events.Event) error {
+	return json.Unmarshal(payload, event)
+}
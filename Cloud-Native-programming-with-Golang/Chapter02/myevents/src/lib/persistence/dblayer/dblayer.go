@@ -2,7 +2,9 @@ package dblayer
 
 import (
 	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/persistence"
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/persistence/dynamolayer"
 	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/persistence/mongolayer"
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/tracing"
 )
 
 type DBTYPE string
@@ -12,11 +14,23 @@ const (
 	DYNAMODB DBTYPE = "dynamodb"
 )
 
+// NewPersistenceLayer builds the persistence.DatabaseHandler for options,
+// wrapped so every call it makes is traced as a "db.<method>" span.
 func NewPersistenceLayer(options DBTYPE, connection string) (persistence.DatabaseHandler, error) {
 
 	switch options {
 	case MONGODB:
-		return mongolayer.NewMongoDBLayer(connection)
+		handler, err := mongolayer.NewMongoDBLayer(connection)
+		if err != nil {
+			return nil, err
+		}
+		return tracing.NewDatabaseHandler(handler, tracing.BackendMongoDB), nil
+	case DYNAMODB:
+		handler, err := dynamolayer.NewDynamoDBLayer(connection)
+		if err != nil {
+			return nil, err
+		}
+		return tracing.NewDatabaseHandler(handler, tracing.BackendDynamoDB), nil
 	}
 	return nil, nil
 }
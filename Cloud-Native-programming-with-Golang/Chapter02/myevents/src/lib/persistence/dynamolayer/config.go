@@ -0,0 +1,92 @@
+package dynamolayer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+// Config describes how to reach the DynamoDB tables backing the
+// persistence layer. It is parsed out of the connection string passed to
+// dblayer.NewPersistenceLayer, which looks like:
+//
+//	region=us-east-1;table-prefix=myevents_;endpoint=http://localhost:8000
+//
+// Every field but Region has a sane default, so a bare "region=..."
+// connection string is enough against real DynamoDB.
+type Config struct {
+	// Region is the AWS region the tables live in.
+	Region string
+	// TablePrefix is prepended to every table name (Users, Events,
+	// Locations, Outbox), so the same account can host several environments.
+	TablePrefix string
+	// Endpoint overrides the DynamoDB endpoint, for pointing the layer at
+	// DynamoDB Local during development and integration tests. Empty
+	// means "use the regular AWS endpoint for Region".
+	Endpoint string
+}
+
+// ParseConfig parses a dynamolayer connection string of the form
+// "key=value;key=value;...". Recognised keys are region, table-prefix
+// and endpoint; unknown keys are ignored so the same connection string
+// format can later grow without breaking older binaries.
+func ParseConfig(connection string) Config {
+	cfg := Config{}
+	for _, pair := range strings.Split(connection, ";") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		switch key {
+		case "region":
+			cfg.Region = value
+		case "table-prefix":
+			cfg.TablePrefix = value
+		case "endpoint":
+			cfg.Endpoint = value
+		}
+	}
+	return cfg
+}
+
+func (c Config) usersTable() string {
+	return c.TablePrefix + "Users"
+}
+
+func (c Config) eventsTable() string {
+	return c.TablePrefix + "Events"
+}
+
+func (c Config) locationsTable() string {
+	return c.TablePrefix + "Locations"
+}
+
+func (c Config) outboxTable() string {
+	return c.TablePrefix + "Outbox"
+}
+
+// newClient builds the AWS SDK v2 DynamoDB client described by c.
+func (c Config) newClient(ctx context.Context) (*dynamodb.Client, error) {
+	optFns := []func(*config.LoadOptions) error{
+		config.WithRegion(c.Region),
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamodb.NewFromConfig(awsCfg, func(o *dynamodb.Options) {
+		if c.Endpoint != "" {
+			o.BaseEndpoint = aws.String(c.Endpoint)
+		}
+	}), nil
+}
@@ -0,0 +1,404 @@
+// Package dynamolayer implements persistence.DatabaseHandler on top of
+// Amazon DynamoDB, mirroring the table-per-collection layout mongolayer
+// keeps in MongoDB (Users, Events, Locations, Outbox). Every record stores its
+// key under the "ID" attribute, via the persistence.ID abstraction, so
+// callers never have to know which backend they are talking to.
+package dynamolayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/events"
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/persistence"
+)
+
+func unixMillis() int64 { return time.Now().UnixMilli() }
+
+// DynamoDBLayer implements persistence.DatabaseHandler against DynamoDB.
+type DynamoDBLayer struct {
+	client dynamodb.Client
+
+	usersTable     string
+	eventsTable    string
+	locationsTable string
+	outboxTable    string
+
+	now func() int64
+}
+
+// NewDynamoDBLayer connects to DynamoDB using the connection string
+// produced by ParseConfig, e.g.
+// "region=us-east-1;table-prefix=myevents_;endpoint=http://localhost:8000".
+func NewDynamoDBLayer(connection string) (persistence.DatabaseHandler, error) {
+	cfg := ParseConfig(connection)
+
+	ctx := context.Background()
+	client, err := cfg.newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DynamoDBLayer{
+		client:         *client,
+		usersTable:     cfg.usersTable(),
+		eventsTable:    cfg.eventsTable(),
+		locationsTable: cfg.locationsTable(),
+		outboxTable:    cfg.outboxTable(),
+		now:            unixMillis,
+	}, nil
+}
+
+func (layer *DynamoDBLayer) AddUser(ctx context.Context, user persistence.User) (persistence.ID, error) {
+	if user.ID.IsZero() {
+		user.ID = persistence.NewID()
+	}
+
+	item, err := attributevalue.MarshalMap(user)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = layer.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: &layer.usersTable,
+		Item:      item,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user.ID, nil
+}
+
+func (layer *DynamoDBLayer) AddEvent(ctx context.Context, event persistence.Event) (persistence.ID, error) {
+	if event.ID.IsZero() {
+		event.ID = persistence.NewID()
+	}
+
+	item, err := attributevalue.MarshalMap(event)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = layer.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: &layer.eventsTable,
+		Item:      item,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return event.ID, nil
+}
+
+// AddCourseingForUser appends courseing to the user's record and writes
+// the outbox entry for the resulting events.CourseedEvent in a single
+// DynamoDB TransactWriteItems call, so a crash right after this call
+// returns either both writes or neither - never a courseing with no
+// corresponding notification, or a notification for a courseing that
+// was never actually saved.
+func (layer *DynamoDBLayer) AddCourseingForUser(ctx context.Context, userID persistence.ID, courseing persistence.Courseing) error {
+	user, err := layer.findUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	user.Courseings = append(user.Courseings, courseing)
+
+	userItem, err := attributevalue.MarshalMap(user)
+	if err != nil {
+		return err
+	}
+
+	outboxItem, err := layer.outboxItem("users", &events.CourseedEvent{
+		EventID: courseing.EventID.String(),
+		UserID:  userID.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = layer.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{Put: &types.Put{TableName: &layer.usersTable, Item: userItem}},
+			{Put: &types.Put{TableName: &layer.outboxTable, Item: outboxItem}},
+		},
+	})
+	return err
+}
+
+func (layer *DynamoDBLayer) AddLocation(ctx context.Context, location persistence.Location) (persistence.Location, error) {
+	if location.ID.IsZero() {
+		location.ID = persistence.NewID()
+	}
+
+	item, err := attributevalue.MarshalMap(location)
+	if err != nil {
+		return persistence.Location{}, err
+	}
+
+	_, err = layer.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: &layer.locationsTable,
+		Item:      item,
+	})
+	if err != nil {
+		return persistence.Location{}, err
+	}
+	return location, nil
+}
+
+func (layer *DynamoDBLayer) FindUser(ctx context.Context, first, last string) (persistence.User, error) {
+	out, err := layer.client.Scan(ctx, &dynamodb.ScanInput{
+		TableName:        &layer.usersTable,
+		FilterExpression: awsString("First = :first AND #last = :last"),
+		ExpressionAttributeNames: map[string]string{
+			"#last": "Last",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":first": &types.AttributeValueMemberS{Value: first},
+			":last":  &types.AttributeValueMemberS{Value: last},
+		},
+	})
+	if err != nil {
+		return persistence.User{}, err
+	}
+	if len(out.Items) == 0 {
+		return persistence.User{}, errUserNotFound
+	}
+
+	var user persistence.User
+	if err := attributevalue.UnmarshalMap(out.Items[0], &user); err != nil {
+		return persistence.User{}, err
+	}
+	return user, nil
+}
+
+func (layer *DynamoDBLayer) FindCourseingsForUser(ctx context.Context, userID persistence.ID) ([]persistence.Courseing, error) {
+	user, err := layer.findUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return user.Courseings, nil
+}
+
+func (layer *DynamoDBLayer) FindEvent(ctx context.Context, id persistence.ID) (persistence.Event, error) {
+	key, err := attributevalue.MarshalMap(struct{ ID persistence.ID }{ID: id})
+	if err != nil {
+		return persistence.Event{}, err
+	}
+
+	out, err := layer.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: &layer.eventsTable,
+		Key:       key,
+	})
+	if err != nil {
+		return persistence.Event{}, err
+	}
+	if out.Item == nil {
+		return persistence.Event{}, errEventNotFound
+	}
+
+	var event persistence.Event
+	if err := attributevalue.UnmarshalMap(out.Item, &event); err != nil {
+		return persistence.Event{}, err
+	}
+	return event, nil
+}
+
+func (layer *DynamoDBLayer) FindEventByName(ctx context.Context, name string) (persistence.Event, error) {
+	out, err := layer.client.Scan(ctx, &dynamodb.ScanInput{
+		TableName:        &layer.eventsTable,
+		FilterExpression: awsString("EventName = :name"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":name": &types.AttributeValueMemberS{Value: name},
+		},
+	})
+	if err != nil {
+		return persistence.Event{}, err
+	}
+	if len(out.Items) == 0 {
+		return persistence.Event{}, errEventNotFound
+	}
+
+	var event persistence.Event
+	if err := attributevalue.UnmarshalMap(out.Items[0], &event); err != nil {
+		return persistence.Event{}, err
+	}
+	return event, nil
+}
+
+func (layer *DynamoDBLayer) FindAllAvailableEvents(ctx context.Context) ([]persistence.Event, error) {
+	out, err := layer.client.Scan(ctx, &dynamodb.ScanInput{
+		TableName: &layer.eventsTable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]persistence.Event, 0, len(out.Items))
+	if err := attributevalue.UnmarshalListOfMaps(out.Items, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (layer *DynamoDBLayer) FindLocation(ctx context.Context, name string) (persistence.Location, error) {
+	out, err := layer.client.Scan(ctx, &dynamodb.ScanInput{
+		TableName:        &layer.locationsTable,
+		FilterExpression: awsString("#name = :name"),
+		ExpressionAttributeNames: map[string]string{
+			"#name": "Name",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":name": &types.AttributeValueMemberS{Value: name},
+		},
+	})
+	if err != nil {
+		return persistence.Location{}, err
+	}
+	if len(out.Items) == 0 {
+		return persistence.Location{}, errLocationNotFound
+	}
+
+	var location persistence.Location
+	if err := attributevalue.UnmarshalMap(out.Items[0], &location); err != nil {
+		return persistence.Location{}, err
+	}
+	return location, nil
+}
+
+func (layer *DynamoDBLayer) FindAllLocations(ctx context.Context) ([]persistence.Location, error) {
+	out, err := layer.client.Scan(ctx, &dynamodb.ScanInput{
+		TableName: &layer.locationsTable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]persistence.Location, 0, len(out.Items))
+	if err := attributevalue.UnmarshalListOfMaps(out.Items, &locations); err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+func (layer *DynamoDBLayer) findUserByID(ctx context.Context, id persistence.ID) (persistence.User, error) {
+	key, err := attributevalue.MarshalMap(struct{ ID persistence.ID }{ID: id})
+	if err != nil {
+		return persistence.User{}, err
+	}
+
+	out, err := layer.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: &layer.usersTable,
+		Key:       key,
+	})
+	if err != nil {
+		return persistence.User{}, err
+	}
+	if out.Item == nil {
+		return persistence.User{}, errUserNotFound
+	}
+
+	var user persistence.User
+	if err := attributevalue.UnmarshalMap(out.Item, &user); err != nil {
+		return persistence.User{}, err
+	}
+	return user, nil
+}
+
+// RecordOutboxEvent writes a standalone outbox entry for event, outside
+// of any other write. It is used by callers that need to enqueue a
+// notification without an accompanying business-data change, such as
+// the booking saga's compensating cancellation.
+func (layer *DynamoDBLayer) RecordOutboxEvent(ctx context.Context, aggregate string, event events.Event) (persistence.ID, error) {
+	item, id, err := layer.marshalOutboxEntry(aggregate, event)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = layer.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: &layer.outboxTable,
+		Item:      item,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+func (layer *DynamoDBLayer) FindUnpublishedOutboxEntries(ctx context.Context) ([]persistence.OutboxEntry, error) {
+	out, err := layer.client.Scan(ctx, &dynamodb.ScanInput{
+		TableName:        &layer.outboxTable,
+		FilterExpression: awsString("PublishedAt = :zero"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":zero": &types.AttributeValueMemberN{Value: "0"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]persistence.OutboxEntry, 0, len(out.Items))
+	if err := attributevalue.UnmarshalListOfMaps(out.Items, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (layer *DynamoDBLayer) MarkOutboxPublished(ctx context.Context, id persistence.ID, publishedAt int64) error {
+	key, err := attributevalue.MarshalMap(struct{ ID persistence.ID }{ID: id})
+	if err != nil {
+		return err
+	}
+
+	_, err = layer.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:        &layer.outboxTable,
+		Key:              key,
+		UpdateExpression: awsString("SET PublishedAt = :publishedAt"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":publishedAt": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", publishedAt)},
+		},
+	})
+	return err
+}
+
+// outboxItem marshals event into the DynamoDB item shape used by the
+// outbox table, ready to be placed in a TransactWriteItems Put.
+func (layer *DynamoDBLayer) outboxItem(aggregate string, event events.Event) (map[string]types.AttributeValue, error) {
+	item, _, err := layer.marshalOutboxEntry(aggregate, event)
+	return item, err
+}
+
+func (layer *DynamoDBLayer) marshalOutboxEntry(aggregate string, event events.Event) (map[string]types.AttributeValue, persistence.ID, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dynamolayer: marshal outbox payload: %w", err)
+	}
+
+	entry := persistence.OutboxEntry{
+		ID:        persistence.NewID(),
+		Aggregate: aggregate,
+		EventType: event.EventName(),
+		Payload:   payload,
+		CreatedAt: layer.now(),
+	}
+
+	item, err := attributevalue.MarshalMap(entry)
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, entry.ID, nil
+}
+
+func awsString(s string) *string { return &s }
+
+var (
+	errUserNotFound     = errors.New("dynamolayer: user not found")
+	errEventNotFound    = errors.New("dynamolayer: event not found")
+	errLocationNotFound = errors.New("dynamolayer: location not found")
+)
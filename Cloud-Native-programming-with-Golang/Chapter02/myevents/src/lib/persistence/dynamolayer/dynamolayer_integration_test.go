@@ -0,0 +1,129 @@
+//go:build integration
+
+package dynamolayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/persistence"
+)
+
+// These tests expect DynamoDB Local to be reachable, e.g. via
+//
+//	docker-compose -f ../../../../docker-compose.dynamodb.yml up -d
+//
+// TestMain creates the Users, Events, Locations and Outbox tables
+// itself, so the suite doesn't depend on anything being pre-created by
+// hand.
+const testConnection = "region=us-east-1;table-prefix=myevents_test_;endpoint=http://localhost:8000"
+
+func TestMain(m *testing.M) {
+	cfg := ParseConfig(testConnection)
+
+	ctx := context.Background()
+	client, err := cfg.newClient(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dynamolayer: build test client: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, table := range []string{cfg.usersTable(), cfg.eventsTable(), cfg.locationsTable(), cfg.outboxTable()} {
+		if err := createTableIfNotExists(ctx, client, table); err != nil {
+			fmt.Fprintf(os.Stderr, "dynamolayer: create table %s: %v\n", table, err)
+			os.Exit(1)
+		}
+	}
+
+	os.Exit(m.Run())
+}
+
+func createTableIfNotExists(ctx context.Context, client *dynamodb.Client, table string) error {
+	_, err := client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: &table,
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: stringPtr("ID"), AttributeType: types.ScalarAttributeTypeB},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: stringPtr("ID"), KeyType: types.KeyTypeHash},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	})
+
+	var inUse *types.ResourceInUseException
+	if errors.As(err, &inUse) {
+		return nil
+	}
+	return err
+}
+
+func stringPtr(s string) *string { return &s }
+
+func newTestLayer(t *testing.T) *DynamoDBLayer {
+	t.Helper()
+
+	handler, err := NewDynamoDBLayer(testConnection)
+	if err != nil {
+		t.Fatalf("NewDynamoDBLayer: %v", err)
+	}
+	return handler.(*DynamoDBLayer)
+}
+
+func TestAddAndFindEvent(t *testing.T) {
+	layer := newTestLayer(t)
+	ctx := context.Background()
+
+	event := persistence.Event{
+		Name:      "GolangCon",
+		Duration:  2,
+		StartDate: 1609459200,
+		EndDate:   1609545600,
+	}
+
+	id, err := layer.AddEvent(ctx, event)
+	if err != nil {
+		t.Fatalf("AddEvent: %v", err)
+	}
+
+	found, err := layer.FindEvent(ctx, id)
+	if err != nil {
+		t.Fatalf("FindEvent: %v", err)
+	}
+	if found.Name != event.Name {
+		t.Fatalf("FindEvent returned %q, want %q", found.Name, event.Name)
+	}
+}
+
+func TestAddCourseingForUser(t *testing.T) {
+	layer := newTestLayer(t)
+	ctx := context.Background()
+
+	userID, err := layer.AddUser(ctx, persistence.User{First: "Ada", Last: "Lovelace", Age: 28})
+	if err != nil {
+		t.Fatalf("AddUser: %v", err)
+	}
+
+	eventID, err := layer.AddEvent(ctx, persistence.Event{Name: "GopherCon"})
+	if err != nil {
+		t.Fatalf("AddEvent: %v", err)
+	}
+
+	err = layer.AddCourseingForUser(ctx, userID, persistence.Courseing{Date: 1609459200, EventID: eventID, Seats: 2})
+	if err != nil {
+		t.Fatalf("AddCourseingForUser: %v", err)
+	}
+
+	courseings, err := layer.FindCourseingsForUser(ctx, userID)
+	if err != nil {
+		t.Fatalf("FindCourseingsForUser: %v", err)
+	}
+	if len(courseings) != 1 || courseings[0].Seats != 2 {
+		t.Fatalf("FindCourseingsForUser = %v, want a single courseing with 2 seats", courseings)
+	}
+}
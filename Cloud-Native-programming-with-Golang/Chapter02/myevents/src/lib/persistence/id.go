@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ID is an opaque record identifier. It lets callers pass an identifier
+// around without knowing whether the backing store is MongoDB or
+// DynamoDB; both store the same raw bytes, as a binary value.
+type ID []byte
+
+// NewID generates a fresh ID using the same representation MongoDB would
+// have produced, so existing callers that persisted a MongoDB ObjectId
+// keep working unmodified.
+func NewID() ID {
+	return ID(bson.NewObjectId())
+}
+
+// IDFromString parses the wire/string form of an ID, as produced by
+// String. It accepts both the 24-character hex form used by MongoDB -
+// decoding it back into the raw ObjectId bytes, so it round-trips with
+// Hex/String - and arbitrary opaque strings used by other backends,
+// which are kept as their raw bytes.
+func IDFromString(s string) ID {
+	if bson.IsObjectIdHex(s) {
+		return ID(bson.ObjectIdHex(s))
+	}
+	return ID(s)
+}
+
+// Hex returns the hexadecimal encoding of the ID, matching
+// bson.ObjectId.Hex for MongoDB-backed records.
+func (id ID) Hex() string {
+	return bson.ObjectId(id).Hex()
+}
+
+// String returns the ID in the canonical form used to pass it across
+// process boundaries (HTTP routes, messages, etc).
+func (id ID) String() string {
+	if bson.ObjectId(id).Valid() {
+		return bson.ObjectId(id).Hex()
+	}
+	return string(id)
+}
+
+// IsZero reports whether the ID has never been set.
+func (id ID) IsZero() bool {
+	return len(id) == 0
+}
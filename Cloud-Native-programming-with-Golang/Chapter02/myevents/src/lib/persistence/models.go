@@ -0,0 +1,67 @@
+package persistence
+
+import (
+	"fmt"
+)
+
+type User struct {
+	ID         ID `bson:"_id" dynamodbav:"ID"`
+	First      string
+	Last       string
+	Age        int
+	Courseings []Courseing
+}
+
+func (u *User) String() string {
+	return fmt.Sprintf("id: %s, first_name: %s, last_name: %s, Age: %d, Courseings: %v", u.ID, u.First, u.Last, u.Age, u.Courseings)
+}
+
+type Courseing struct {
+	Date    int64
+	EventID ID `dynamodbav:"EventID"`
+	Seats   int
+}
+
+type Event struct {
+	ID        ID     `bson:"_id" dynamodbav:"ID"`
+	Name      string `dynamodbav:"EventName"`
+	Duration  int
+	StartDate int64
+	EndDate   int64
+	Location  Location
+}
+
+type Location struct {
+	ID        ID `bson:"_id" dynamodbav:"ID"`
+	Name      string
+	Address   string
+	Country   string
+	OpenTime  int
+	CloseTime int
+	Halls     []Hall
+}
+
+type Hall struct {
+	Name     string `json:"name"`
+	Location string `json:"location,omitempty"`
+	Capacity int    `json:"capacity"`
+}
+
+// OutboxEntry is a row of the transactional outbox: it is written in
+// the same transaction as the business change it describes, and a
+// background outbox.Relay later publishes it through the existing
+// emitter and stamps PublishedAt, giving at-least-once delivery without
+// a distributed transaction between the database and the broker.
+type OutboxEntry struct {
+	ID          ID     `bson:"_id" dynamodbav:"ID"`
+	Aggregate   string `dynamodbav:"Aggregate"`
+	EventType   string `dynamodbav:"EventType"`
+	Payload     []byte `dynamodbav:"Payload"`
+	CreatedAt   int64  `dynamodbav:"CreatedAt"`
+	PublishedAt int64  `dynamodbav:"PublishedAt"`
+}
+
+// Published reports whether the relay has already delivered this entry.
+func (o OutboxEntry) Published() bool {
+	return o.PublishedAt != 0
+}
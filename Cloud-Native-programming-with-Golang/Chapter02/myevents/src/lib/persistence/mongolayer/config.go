@@ -0,0 +1,42 @@
+package mongolayer
+
+import "strings"
+
+// Config describes how to reach the MongoDB database backing the
+// persistence layer. It is parsed out of the connection string passed
+// to dblayer.NewPersistenceLayer, which looks like:
+//
+//	uri=mongodb://localhost:27017;database=myevents
+type Config struct {
+	// URI is the MongoDB connection URI, e.g. "mongodb://localhost:27017".
+	URI string
+	// Database is the name of the database holding the Users, Events,
+	// Locations and Outbox collections.
+	Database string
+}
+
+// ParseConfig parses a mongolayer connection string of the form
+// "key=value;key=value;...". Recognised keys are uri and database;
+// unknown keys are ignored so the connection string format can later
+// grow without breaking older binaries.
+func ParseConfig(connection string) Config {
+	cfg := Config{Database: "myevents"}
+	for _, pair := range strings.Split(connection, ";") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		switch key {
+		case "uri":
+			cfg.URI = value
+		case "database":
+			cfg.Database = value
+		}
+	}
+	return cfg
+}
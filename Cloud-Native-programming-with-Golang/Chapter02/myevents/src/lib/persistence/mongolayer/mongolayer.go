@@ -0,0 +1,279 @@
+// Package mongolayer implements persistence.DatabaseHandler on top of
+// MongoDB, storing each collection (Users, Events, Locations, Outbox)
+// the way dynamolayer stores its equivalent DynamoDB tables.
+package mongolayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/events"
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/persistence"
+)
+
+// MongoDBLayer implements persistence.DatabaseHandler against MongoDB.
+type MongoDBLayer struct {
+	client *mongo.Client
+
+	users     *mongo.Collection
+	events    *mongo.Collection
+	locations *mongo.Collection
+	outbox    *mongo.Collection
+
+	now func() int64
+}
+
+// NewMongoDBLayer connects to MongoDB using the connection string
+// produced by ParseConfig, e.g.
+// "uri=mongodb://localhost:27017;database=myevents".
+func NewMongoDBLayer(connection string) (persistence.DatabaseHandler, error) {
+	cfg := ParseConfig(connection)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.URI))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	db := client.Database(cfg.Database)
+	return &MongoDBLayer{
+		client:    client,
+		users:     db.Collection("users"),
+		events:    db.Collection("events"),
+		locations: db.Collection("locations"),
+		outbox:    db.Collection("outbox"),
+		now:       unixMillis,
+	}, nil
+}
+
+func (layer *MongoDBLayer) AddUser(ctx context.Context, user persistence.User) (persistence.ID, error) {
+	if user.ID.IsZero() {
+		user.ID = persistence.NewID()
+	}
+
+	if _, err := layer.users.InsertOne(ctx, user); err != nil {
+		return nil, err
+	}
+	return user.ID, nil
+}
+
+func (layer *MongoDBLayer) AddEvent(ctx context.Context, event persistence.Event) (persistence.ID, error) {
+	if event.ID.IsZero() {
+		event.ID = persistence.NewID()
+	}
+
+	if _, err := layer.events.InsertOne(ctx, event); err != nil {
+		return nil, err
+	}
+	return event.ID, nil
+}
+
+// AddCourseingForUser appends courseing to the user's record and writes
+// the outbox entry for the resulting events.CourseedEvent inside a
+// single MongoDB transaction, so a crash right after this call commits
+// either both writes or neither.
+func (layer *MongoDBLayer) AddCourseingForUser(ctx context.Context, userID persistence.ID, courseing persistence.Courseing) error {
+	outboxEntry, err := newOutboxEntry(layer.now(), "users", &events.CourseedEvent{
+		EventID: courseing.EventID.String(),
+		UserID:  userID.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	session, err := layer.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sCtx mongo.SessionContext) (interface{}, error) {
+		res, err := layer.users.UpdateOne(sCtx,
+			bson.M{"_id": []byte(userID)},
+			bson.M{"$push": bson.M{"courseings": courseing}},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if res.MatchedCount == 0 {
+			return nil, errUserNotFound
+		}
+
+		if _, err := layer.outbox.InsertOne(sCtx, outboxEntry); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	return err
+}
+
+func (layer *MongoDBLayer) AddLocation(ctx context.Context, location persistence.Location) (persistence.Location, error) {
+	if location.ID.IsZero() {
+		location.ID = persistence.NewID()
+	}
+
+	if _, err := layer.locations.InsertOne(ctx, location); err != nil {
+		return persistence.Location{}, err
+	}
+	return location, nil
+}
+
+func (layer *MongoDBLayer) FindUser(ctx context.Context, first, last string) (persistence.User, error) {
+	var user persistence.User
+	err := layer.users.FindOne(ctx, bson.M{"first": first, "last": last}).Decode(&user)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return persistence.User{}, errUserNotFound
+	}
+	return user, err
+}
+
+func (layer *MongoDBLayer) FindCourseingsForUser(ctx context.Context, userID persistence.ID) ([]persistence.Courseing, error) {
+	user, err := layer.findUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return user.Courseings, nil
+}
+
+func (layer *MongoDBLayer) FindEvent(ctx context.Context, id persistence.ID) (persistence.Event, error) {
+	var event persistence.Event
+	err := layer.events.FindOne(ctx, bson.M{"_id": []byte(id)}).Decode(&event)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return persistence.Event{}, errEventNotFound
+	}
+	return event, err
+}
+
+func (layer *MongoDBLayer) FindEventByName(ctx context.Context, name string) (persistence.Event, error) {
+	var event persistence.Event
+	err := layer.events.FindOne(ctx, bson.M{"name": name}).Decode(&event)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return persistence.Event{}, errEventNotFound
+	}
+	return event, err
+}
+
+func (layer *MongoDBLayer) FindAllAvailableEvents(ctx context.Context) ([]persistence.Event, error) {
+	cursor, err := layer.events.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []persistence.Event
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (layer *MongoDBLayer) FindLocation(ctx context.Context, name string) (persistence.Location, error) {
+	var location persistence.Location
+	err := layer.locations.FindOne(ctx, bson.M{"name": name}).Decode(&location)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return persistence.Location{}, errLocationNotFound
+	}
+	return location, err
+}
+
+func (layer *MongoDBLayer) FindAllLocations(ctx context.Context) ([]persistence.Location, error) {
+	cursor, err := layer.locations.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var locations []persistence.Location
+	if err := cursor.All(ctx, &locations); err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+// RecordOutboxEvent writes a standalone outbox entry for event, outside
+// of any other write. It is used by callers that need to enqueue a
+// notification without an accompanying business-data change, such as
+// the booking saga's compensating cancellation.
+func (layer *MongoDBLayer) RecordOutboxEvent(ctx context.Context, aggregate string, event events.Event) (persistence.ID, error) {
+	entry, err := newOutboxEntry(layer.now(), aggregate, event)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := layer.outbox.InsertOne(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry.ID, nil
+}
+
+func (layer *MongoDBLayer) FindUnpublishedOutboxEntries(ctx context.Context) ([]persistence.OutboxEntry, error) {
+	cursor, err := layer.outbox.Find(ctx, bson.M{"publishedat": 0})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []persistence.OutboxEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (layer *MongoDBLayer) MarkOutboxPublished(ctx context.Context, id persistence.ID, publishedAt int64) error {
+	res, err := layer.outbox.UpdateOne(ctx,
+		bson.M{"_id": []byte(id)},
+		bson.M{"$set": bson.M{"publishedat": publishedAt}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("mongolayer: outbox entry %s not found", id)
+	}
+	return nil
+}
+
+func (layer *MongoDBLayer) findUserByID(ctx context.Context, id persistence.ID) (persistence.User, error) {
+	var user persistence.User
+	err := layer.users.FindOne(ctx, bson.M{"_id": []byte(id)}).Decode(&user)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return persistence.User{}, errUserNotFound
+	}
+	return user, err
+}
+
+func newOutboxEntry(createdAt int64, aggregate string, event events.Event) (persistence.OutboxEntry, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return persistence.OutboxEntry{}, fmt.Errorf("mongolayer: marshal outbox payload: %w", err)
+	}
+
+	return persistence.OutboxEntry{
+		ID:        persistence.NewID(),
+		Aggregate: aggregate,
+		EventType: event.EventName(),
+		Payload:   payload,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+func unixMillis() int64 { return time.Now().UnixMilli() }
+
+var (
+	errUserNotFound     = errors.New("mongolayer: user not found")
+	errEventNotFound    = errors.New("mongolayer: event not found")
+	errLocationNotFound = errors.New("mongolayer: location not found")
+)
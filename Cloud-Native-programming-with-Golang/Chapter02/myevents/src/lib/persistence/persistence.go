@@ -0,0 +1,37 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/events"
+)
+
+type DatabaseHandler interface {
+	AddUser(context.Context, User) (ID, error)
+	AddEvent(context.Context, Event) (ID, error)
+	// AddCourseingForUser persists the courseing and an OutboxEntry
+	// carrying the resulting events.CourseedEvent in a single
+	// transaction, so a crash between the write and the publish can
+	// never lose or duplicate the notification.
+	AddCourseingForUser(context.Context, ID, Courseing) error
+	AddLocation(context.Context, Location) (Location, error)
+	FindUser(context.Context, string, string) (User, error)
+	FindCourseingsForUser(context.Context, ID) ([]Courseing, error)
+	FindEvent(context.Context, ID) (Event, error)
+	FindEventByName(context.Context, string) (Event, error)
+	FindAllAvailableEvents(context.Context) ([]Event, error)
+	FindLocation(context.Context, string) (Location, error)
+	FindAllLocations(context.Context) ([]Location, error)
+
+	// RecordOutboxEvent writes a standalone outbox entry for event,
+	// tagged with the aggregate it belongs to. It is used outside of
+	// AddCourseingForUser's own transaction, e.g. by the booking saga's
+	// compensating cancellation.
+	RecordOutboxEvent(ctx context.Context, aggregate string, event events.Event) (ID, error)
+	// FindUnpublishedOutboxEntries returns every OutboxEntry the relay
+	// has not yet published.
+	FindUnpublishedOutboxEntries(ctx context.Context) ([]OutboxEntry, error)
+	// MarkOutboxPublished stamps the entry's PublishedAt so the relay
+	// does not redeliver it.
+	MarkOutboxPublished(ctx context.Context, id ID, publishedAt int64) error
+}
@@ -0,0 +1,164 @@
+// Package tracing adds OpenTracing instrumentation to the myevents
+// service's persistence.DatabaseHandler calls and sets up the Jaeger
+// tracer they report to. It does not instrument outgoing HTTP clients.
+package tracing
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/events"
+	"github.com/ImagineDevOps/Cloud-Native-programming-with-Golang/chapter02/myevents/src/lib/persistence"
+)
+
+// backend identifies which persistence.DatabaseHandler implementation is
+// being traced, so spans can be filtered by backend in the tracing UI.
+type backend string
+
+const (
+	// BackendMongoDB tags spans coming from mongolayer.
+	BackendMongoDB backend = "mongodb"
+	// BackendDynamoDB tags spans coming from dynamolayer.
+	BackendDynamoDB backend = "dynamodb"
+)
+
+// databaseHandler wraps a persistence.DatabaseHandler and starts a
+// "db.<method>" span, tagged with the backend and the collection/table
+// the call touches, around every method.
+type databaseHandler struct {
+	next    persistence.DatabaseHandler
+	backend backend
+}
+
+// NewDatabaseHandler wraps next so every call it makes is traced.
+func NewDatabaseHandler(next persistence.DatabaseHandler, backend backend) persistence.DatabaseHandler {
+	return &databaseHandler{next: next, backend: backend}
+}
+
+func (d *databaseHandler) startSpan(ctx context.Context, method, collection string) (opentracing.Span, context.Context) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "db."+method)
+	ext.DBType.Set(span, string(d.backend))
+	ext.DBInstance.Set(span, collection)
+	return span, ctx
+}
+
+func (d *databaseHandler) AddUser(ctx context.Context, user persistence.User) (persistence.ID, error) {
+	span, ctx := d.startSpan(ctx, "AddUser", "users")
+	defer span.Finish()
+	id, err := d.next.AddUser(ctx, user)
+	finishWithError(span, err)
+	return id, err
+}
+
+func (d *databaseHandler) AddEvent(ctx context.Context, event persistence.Event) (persistence.ID, error) {
+	span, ctx := d.startSpan(ctx, "AddEvent", "events")
+	defer span.Finish()
+	id, err := d.next.AddEvent(ctx, event)
+	finishWithError(span, err)
+	return id, err
+}
+
+func (d *databaseHandler) AddCourseingForUser(ctx context.Context, userID persistence.ID, courseing persistence.Courseing) error {
+	span, ctx := d.startSpan(ctx, "AddCourseingForUser", "users")
+	defer span.Finish()
+	err := d.next.AddCourseingForUser(ctx, userID, courseing)
+	finishWithError(span, err)
+	return err
+}
+
+func (d *databaseHandler) AddLocation(ctx context.Context, location persistence.Location) (persistence.Location, error) {
+	span, ctx := d.startSpan(ctx, "AddLocation", "locations")
+	defer span.Finish()
+	loc, err := d.next.AddLocation(ctx, location)
+	finishWithError(span, err)
+	return loc, err
+}
+
+func (d *databaseHandler) FindUser(ctx context.Context, first, last string) (persistence.User, error) {
+	span, ctx := d.startSpan(ctx, "FindUser", "users")
+	defer span.Finish()
+	user, err := d.next.FindUser(ctx, first, last)
+	finishWithError(span, err)
+	return user, err
+}
+
+func (d *databaseHandler) FindCourseingsForUser(ctx context.Context, userID persistence.ID) ([]persistence.Courseing, error) {
+	span, ctx := d.startSpan(ctx, "FindCourseingsForUser", "users")
+	defer span.Finish()
+	courseings, err := d.next.FindCourseingsForUser(ctx, userID)
+	finishWithError(span, err)
+	return courseings, err
+}
+
+func (d *databaseHandler) FindEvent(ctx context.Context, id persistence.ID) (persistence.Event, error) {
+	span, ctx := d.startSpan(ctx, "FindEvent", "events")
+	defer span.Finish()
+	event, err := d.next.FindEvent(ctx, id)
+	finishWithError(span, err)
+	return event, err
+}
+
+func (d *databaseHandler) FindEventByName(ctx context.Context, name string) (persistence.Event, error) {
+	span, ctx := d.startSpan(ctx, "FindEventByName", "events")
+	defer span.Finish()
+	event, err := d.next.FindEventByName(ctx, name)
+	finishWithError(span, err)
+	return event, err
+}
+
+func (d *databaseHandler) FindAllAvailableEvents(ctx context.Context) ([]persistence.Event, error) {
+	span, ctx := d.startSpan(ctx, "FindAllAvailableEvents", "events")
+	defer span.Finish()
+	events, err := d.next.FindAllAvailableEvents(ctx)
+	finishWithError(span, err)
+	return events, err
+}
+
+func (d *databaseHandler) FindLocation(ctx context.Context, name string) (persistence.Location, error) {
+	span, ctx := d.startSpan(ctx, "FindLocation", "locations")
+	defer span.Finish()
+	location, err := d.next.FindLocation(ctx, name)
+	finishWithError(span, err)
+	return location, err
+}
+
+func (d *databaseHandler) FindAllLocations(ctx context.Context) ([]persistence.Location, error) {
+	span, ctx := d.startSpan(ctx, "FindAllLocations", "locations")
+	defer span.Finish()
+	locations, err := d.next.FindAllLocations(ctx)
+	finishWithError(span, err)
+	return locations, err
+}
+
+func (d *databaseHandler) RecordOutboxEvent(ctx context.Context, aggregate string, event events.Event) (persistence.ID, error) {
+	span, ctx := d.startSpan(ctx, "RecordOutboxEvent", "outbox")
+	defer span.Finish()
+	id, err := d.next.RecordOutboxEvent(ctx, aggregate, event)
+	finishWithError(span, err)
+	return id, err
+}
+
+func (d *databaseHandler) FindUnpublishedOutboxEntries(ctx context.Context) ([]persistence.OutboxEntry, error) {
+	span, ctx := d.startSpan(ctx, "FindUnpublishedOutboxEntries", "outbox")
+	defer span.Finish()
+	entries, err := d.next.FindUnpublishedOutboxEntries(ctx)
+	finishWithError(span, err)
+	return entries, err
+}
+
+func (d *databaseHandler) MarkOutboxPublished(ctx context.Context, id persistence.ID, publishedAt int64) error {
+	span, ctx := d.startSpan(ctx, "MarkOutboxPublished", "outbox")
+	defer span.Finish()
+	err := d.next.MarkOutboxPublished(ctx, id, publishedAt)
+	finishWithError(span, err)
+	return err
+}
+
+func finishWithError(span opentracing.Span, err error) {
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogKV("error.message", err.Error())
+	}
+}
@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"io"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/jaeger-client-go"
+	jaegercfg "github.com/uber/jaeger-client-go/config"
+)
+
+// InitJaeger configures opentracing.GlobalTracer to report spans to a
+// local Jaeger agent and returns the io.Closer the service's main should
+// defer-close on shutdown to flush any buffered spans.
+func InitJaeger(serviceName string) (io.Closer, error) {
+	cfg := jaegercfg.Configuration{
+		ServiceName: serviceName,
+		Sampler: &jaegercfg.SamplerConfig{
+			Type:  jaeger.SamplerTypeConst,
+			Param: 1,
+		},
+		Reporter: &jaegercfg.ReporterConfig{
+			LogSpans: true,
+		},
+	}
+
+	tracer, closer, err := cfg.NewTracer(jaegercfg.Logger(jaeger.StdLogger))
+	if err != nil {
+		return nil, err
+	}
+
+	opentracing.SetGlobalTracer(tracer)
+	return closer, nil
+}
@@ -10,3 +10,9 @@ type EventCourseedEvent struct {
 func (c *EventCourseedEvent) EventName() string {
 	return "eventCourseed"
 }
+
+// RoutingIDs returns the user and event IDs the pubsub bridge uses to
+// route this event to the right Redis channels.
+func (c *EventCourseedEvent) RoutingIDs() (userID, eventID string) {
+	return c.UserID, c.EventID
+}
@@ -0,0 +1,19 @@
+package contracts
+
+// EventCancelledEvent is emitted whenever a courseing is cancelled, either
+// by the user or as a compensating action when a downstream step fails.
+type EventCancelledEvent struct {
+	EventID string `json:"eventId"`
+	UserID  string `json:"userId"`
+}
+
+// EventName returns the event's name
+func (c *EventCancelledEvent) EventName() string {
+	return "eventCancelled"
+}
+
+// RoutingIDs returns the user and event IDs the pubsub bridge uses to
+// route this event to the right Redis channels.
+func (c *EventCancelledEvent) RoutingIDs() (userID, eventID string) {
+	return c.UserID, c.EventID
+}
@@ -0,0 +1,58 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming/chapter03/src/lib/emitting"
+)
+
+// routable is implemented by every event the Publisher knows how to
+// route to a user and an event channel (contracts.EventCourseedEvent,
+// contracts.EventCancelledEvent, ...).
+type routable interface {
+	RoutingIDs() (userID, eventID string)
+}
+
+// Publisher wraps an existing emitting.Emitter so that every event it
+// emits is also PUBLISHed to Redis as JSON, in addition to whatever the
+// AMQP/Kafka emitter already does with it.
+type Publisher struct {
+	emitting.Emitter
+	client PubSubClient
+}
+
+// NewPublisher wraps emitter so every event passed to Emit is also
+// mirrored onto client.
+func NewPublisher(emitter emitting.Emitter, client PubSubClient) *Publisher {
+	return &Publisher{Emitter: emitter, client: client}
+}
+
+// Emit forwards event to the wrapped emitter and, on success, publishes
+// it to Redis under both its user and event channels.
+func (p *Publisher) Emit(event emitting.EventEmitter) error {
+	if err := p.Emitter.Emit(event); err != nil {
+		return err
+	}
+
+	ids, ok := event.(routable)
+	if !ok {
+		return nil
+	}
+	userID, eventID := ids.RoutingIDs()
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("pubsub: marshal %s: %w", event.EventName(), err)
+	}
+
+	ctx := context.Background()
+	if err := p.client.Publish(ctx, userChannel(userID), payload); err != nil {
+		return fmt.Errorf("pubsub: publish to user channel: %w", err)
+	}
+	if err := p.client.Publish(ctx, eventChannel(eventID), payload); err != nil {
+		return fmt.Errorf("pubsub: publish to event channel: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Package pubsub mirrors booking events onto Redis so that a booking
+// page can watch a courseing live instead of polling the HTTP API.
+//
+// Every event is published twice: once on the "user:{userID}" channel
+// and once on the "event:{eventID}" channel, so a subscriber can choose
+// whichever granularity it cares about.
+package pubsub
+
+import (
+	"context"
+	"time"
+)
+
+// Message is a single value delivered on a subscription.
+type Message struct {
+	Channel string
+	Payload []byte
+}
+
+// PubSubClient is the slice of the Redis client the pubsub subsystem
+// needs. It exists so the WebSocket handler can be unit-tested with a
+// mock instead of a real Redis server (see pubsub/mocks).
+type PubSubClient interface {
+	// Publish sends payload on channel.
+	Publish(ctx context.Context, channel string, payload []byte) error
+	// PSubscribe subscribes to every channel matching pattern and
+	// returns the messages on the returned channel until ctx is
+	// cancelled or Close is called.
+	PSubscribe(ctx context.Context, pattern string) (<-chan Message, error)
+	// Close releases any resources held by the client.
+	Close() error
+}
+
+func userChannel(userID string) string {
+	return "user:" + userID
+}
+
+func eventChannel(eventID string) string {
+	return "event:" + eventID
+}
+
+// UserPattern returns the PSubscribe pattern that matches every message
+// published for a given user.
+func UserPattern(userID string) string {
+	return userChannel(userID)
+}
+
+// backoff is the reconnection schedule used by RedisClient: it doubles
+// from minBackoff up to maxBackoff, and only resets back to minBackoff
+// once a subscription has stayed up for healthyAfter - a connection
+// that drops sooner than that keeps doubling instead of being treated
+// as a fresh, healthy attempt.
+const (
+	minBackoff   = 100 * time.Millisecond
+	maxBackoff   = 30 * time.Second
+	healthyAfter = 10 * time.Second
+)
@@ -0,0 +1,81 @@
+package pubsub
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisClient implements PubSubClient against a real Redis server. It
+// reconnects with exponential backoff whenever the subscription drops.
+type RedisClient struct {
+	rdb *redis.Client
+}
+
+// NewRedisClient builds a RedisClient talking to addr.
+func NewRedisClient(addr string) *RedisClient {
+	return &RedisClient{
+		rdb: redis.NewClient(&redis.Options{Addr: addr}),
+	}
+}
+
+func (c *RedisClient) Publish(ctx context.Context, channel string, payload []byte) error {
+	return c.rdb.Publish(ctx, channel, payload).Err()
+}
+
+func (c *RedisClient) PSubscribe(ctx context.Context, pattern string) (<-chan Message, error) {
+	out := make(chan Message)
+
+	go func() {
+		defer close(out)
+
+		backoff := minBackoff
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+
+			pubsub := c.rdb.PSubscribe(ctx, pattern)
+			ch := pubsub.Channel()
+			connectedAt := time.Now()
+
+			for msg := range ch {
+				select {
+				case out <- Message{Channel: msg.Channel, Payload: []byte(msg.Payload)}:
+				case <-ctx.Done():
+					pubsub.Close()
+					return
+				}
+			}
+			pubsub.Close()
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			if time.Since(connectedAt) >= healthyAfter {
+				backoff = minBackoff
+			} else if backoff < maxBackoff {
+				backoff *= 2
+				if backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+			}
+
+			log.Printf("pubsub: lost subscription to %q, reconnecting in %s", pattern, backoff)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (c *RedisClient) Close() error {
+	return c.rdb.Close()
+}
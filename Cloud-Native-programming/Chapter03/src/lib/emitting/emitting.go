@@ -0,0 +1,16 @@
+// Package emitting publishes domain events coming out of the booking
+// service onto the message broker (AMQP/Kafka) so other services can
+// react to them.
+package emitting
+
+// EventEmitter is implemented by every domain event that can be emitted
+// onto the broker. contracts.EventCourseedEvent and
+// contracts.EventCancelledEvent both satisfy it.
+type EventEmitter interface {
+	EventName() string
+}
+
+// Emitter publishes events onto the configured broker.
+type Emitter interface {
+	Emit(event EventEmitter) error
+}
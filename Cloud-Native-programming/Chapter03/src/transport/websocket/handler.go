@@ -0,0 +1,135 @@
+// Package websocket streams booking events to a connected browser
+// instead of making it poll the REST API. Each connection subscribes to
+// the Redis channel for a single authenticated user and forwards every
+// message it receives as JSON until the client disconnects.
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming/chapter03/src/contracts/pubsub"
+)
+
+// outboxSize is how many unread messages a single connection buffers;
+// once it is full, the oldest unread message is dropped to make room.
+const outboxSize = 32
+
+// Authenticator resolves the HTTP request into the ID of the user
+// watching the page, or an error if the request isn't authenticated.
+type Authenticator func(r *http.Request) (userID string, err error)
+
+// Handler upgrades incoming HTTP requests to WebSocket connections and
+// streams the authenticated user's booking events to them.
+type Handler struct {
+	upgrader     websocket.Upgrader
+	client       pubsub.PubSubClient
+	authenticate Authenticator
+}
+
+// NewHandler builds a Handler that subscribes to client on behalf of
+// whichever user authenticate resolves the request to.
+func NewHandler(client pubsub.PubSubClient, authenticate Authenticator) *Handler {
+	return &Handler{
+		upgrader:     websocket.Upgrader{},
+		client:       client,
+		authenticate: authenticate,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.authenticate(r)
+	if err != nil {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("websocket: upgrade failed for user %s: %v", userID, err)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	messages, err := h.client.PSubscribe(ctx, pubsub.UserPattern(userID))
+	if err != nil {
+		log.Printf("websocket: subscribe failed for user %s: %v", userID, err)
+		return
+	}
+
+	outbox := fanOut(ctx, messages)
+
+	// Detect client-initiated close so we can stop forwarding messages.
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-outbox:
+			if !ok {
+				return
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, msg.Payload); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// fanOut re-publishes messages into a bounded, per-connection channel
+// and silently drops the oldest message once a slow consumer falls
+// outboxSize messages behind, rather than letting a stalled browser
+// tab back up its Redis subscription.
+func fanOut(ctx context.Context, in <-chan pubsub.Message) <-chan pubsub.Message {
+	out := make(chan pubsub.Message, outboxSize)
+
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- msg:
+				default:
+					select {
+					case <-out:
+					default:
+					}
+					select {
+					case out <- msg:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// decode is a convenience used by tests to confirm the payload on the
+// wire is one of the event types the pubsub publisher sends.
+func decode(payload []byte, v interface{}) error {
+	return json.Unmarshal(payload, v)
+}
@@ -0,0 +1,82 @@
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gorillaws "github.com/gorilla/websocket"
+
+	"github.com/ImagineDevOps/Cloud-Native-programming/chapter03/src/contracts/pubsub"
+	"github.com/ImagineDevOps/Cloud-Native-programming/chapter03/src/contracts/pubsub/mocks"
+	"github.com/golang/mock/gomock"
+)
+
+func TestHandlerStreamsMessagesToClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feed := make(chan pubsub.Message, 1)
+	client := mocks.NewMockPubSubClient(ctrl)
+	client.EXPECT().
+		PSubscribe(gomock.Any(), pubsub.UserPattern("user-1")).
+		Return((<-chan pubsub.Message)(feed), nil)
+
+	handler := NewHandler(client, func(r *http.Request) (string, error) {
+		return "user-1", nil
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	wsURL := "ws" + server.URL[len("http"):]
+	conn, _, err := gorillaws.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer conn.Close()
+
+	feed <- pubsub.Message{Channel: "user:user-1", Payload: []byte(`{"eventId":"evt-1","userId":"user-1"}`)}
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	_, payload, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+
+	var got struct {
+		EventID string `json:"eventId"`
+		UserID  string `json:"userId"`
+	}
+	if err := decode(payload, &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got.EventID != "evt-1" || got.UserID != "user-1" {
+		t.Fatalf("got %+v, want eventId=evt-1 userId=user-1", got)
+	}
+}
+
+func TestHandlerRejectsUnauthenticated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockPubSubClient(ctrl)
+	handler := NewHandler(client, func(r *http.Request) (string, error) {
+		return "", context.Canceled
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
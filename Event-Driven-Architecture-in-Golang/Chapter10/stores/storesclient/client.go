@@ -0,0 +1,52 @@
+// Package storesclient is the go-swagger generated client for the
+// stores service, augmented with a traced default HTTPClient so every
+// call made through it (GetStoreProductParams included) is visible to
+// the distributed tracer.
+package storesclient
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"eda-in-golang/stores/storesclient/product"
+	"eda-in-golang/stores/tracing"
+)
+
+const (
+	// DefaultHost is used if no host is set.
+	DefaultHost = "localhost"
+	// DefaultBasePath is used if no base path is set.
+	DefaultBasePath = "/"
+)
+
+// DefaultSchemes are the default schemes found in the swagger spec.
+var DefaultSchemes = []string{"http"}
+
+// DefaultHTTPClient is the *http.Client go-swagger's generated
+// transport should be built with. Wrapping its Transport in
+// tracing.RoundTripper means every request a GetStoreProductParams
+// call makes is reported as a child "HTTP Client" span.
+var DefaultHTTPClient = &http.Client{
+	Transport: tracing.NewRoundTripper(http.DefaultTransport),
+}
+
+// Client is the stores service API client.
+type Client struct {
+	Product product.ClientService
+}
+
+// NewHTTPClient creates a new stores service client against
+// DefaultHost, routing every request through DefaultHTTPClient.
+func NewHTTPClient(formats strfmt.Registry) *Client {
+	return New(httptransport.NewWithClient(DefaultHost, DefaultBasePath, DefaultSchemes, DefaultHTTPClient), formats)
+}
+
+// New creates a new stores service client backed by transport.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *Client {
+	return &Client{
+		Product: product.New(transport, formats),
+	}
+}
@@ -0,0 +1,34 @@
+package tracing
+
+import (
+	"io"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/jaeger-client-go"
+	jaegercfg "github.com/uber/jaeger-client-go/config"
+)
+
+// InitJaeger configures opentracing.GlobalTracer to report spans for
+// serviceName to a local Jaeger agent. Call it once from the service's
+// main and defer-close the returned io.Closer to flush buffered spans
+// on shutdown.
+func InitJaeger(serviceName string) (io.Closer, error) {
+	cfg := jaegercfg.Configuration{
+		ServiceName: serviceName,
+		Sampler: &jaegercfg.SamplerConfig{
+			Type:  jaeger.SamplerTypeConst,
+			Param: 1,
+		},
+		Reporter: &jaegercfg.ReporterConfig{
+			LogSpans: true,
+		},
+	}
+
+	tracer, closer, err := cfg.NewTracer(jaegercfg.Logger(jaeger.StdLogger))
+	if err != nil {
+		return nil, err
+	}
+
+	opentracing.SetGlobalTracer(tracer)
+	return closer, nil
+}
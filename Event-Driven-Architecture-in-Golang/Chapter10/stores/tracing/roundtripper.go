@@ -0,0 +1,88 @@
+// Package tracing instruments the storesclient's outgoing HTTP calls
+// with OpenTracing, so a call to GetStoreProduct shows up as a child
+// span of whatever request triggered it.
+package tracing
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+// maxLoggedBodyBytes caps how much of a request/response body is
+// attached to a span log, so a large catalog payload doesn't blow up
+// the tracing backend.
+const maxLoggedBodyBytes = 2048
+
+// RoundTripper wraps an http.RoundTripper, starting an "HTTP Client"
+// span for every request it sends and injecting the trace context into
+// the outgoing request's headers.
+type RoundTripper struct {
+	next http.RoundTripper
+}
+
+// NewRoundTripper wraps next, or http.DefaultTransport if next is nil.
+func NewRoundTripper(next http.RoundTripper) *RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &RoundTripper{next: next}
+}
+
+func (rt *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	span, ctx := opentracing.StartSpanFromContext(req.Context(), "HTTP Client")
+	defer span.Finish()
+	req = req.WithContext(ctx)
+
+	ext.SpanKindRPCClient.Set(span)
+	ext.HTTPUrl.Set(span, req.URL.String())
+	ext.HTTPMethod.Set(span, req.Method)
+
+	if err := opentracing.GlobalTracer().Inject(
+		span.Context(),
+		opentracing.HTTPHeaders,
+		opentracing.HTTPHeadersCarrier(req.Header),
+	); err != nil {
+		span.LogKV("event", "inject failed", "error.message", err.Error())
+	}
+
+	logBody(span, "request.body", req.Body, func(body io.ReadCloser) { req.Body = body })
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogKV("error.message", err.Error())
+		return nil, err
+	}
+
+	ext.HTTPStatusCode.Set(span, uint16(resp.StatusCode))
+	logBody(span, "response.body", resp.Body, func(body io.ReadCloser) { resp.Body = body })
+
+	return resp, nil
+}
+
+// logBody records up to maxLoggedBodyBytes of body as a span log under
+// key, while leaving the body readable by whoever reads it next.
+func logBody(span opentracing.Span, key string, body io.ReadCloser, restore func(io.ReadCloser)) {
+	if body == nil {
+		return
+	}
+
+	full, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		span.LogKV("event", "read body failed", "error.message", err.Error())
+		restore(io.NopCloser(bytes.NewReader(nil)))
+		return
+	}
+
+	logged := full
+	if len(logged) > maxLoggedBodyBytes {
+		logged = logged[:maxLoggedBodyBytes]
+	}
+	span.LogKV(key, string(logged))
+	restore(io.NopCloser(bytes.NewReader(full)))
+}
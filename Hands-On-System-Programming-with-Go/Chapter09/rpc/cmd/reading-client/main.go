@@ -0,0 +1,132 @@
+// Command reading-client is a CLI for the reading.ReadingList gRPC
+// service, with one subcommand per RPC: add, remove, progress, set,
+// advance.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/ImagineDevOps/Hands-On-System-Programming-with-Go/Chapter09/rpc/reading"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:4200", "reading-server address")
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+	}
+
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("dial %s: %v", *addr, err)
+	}
+	defer conn.Close()
+
+	client := reading.NewReadingListClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch args[0] {
+	case "add":
+		runAdd(ctx, client, args[1:])
+	case "remove":
+		runRemove(ctx, client, args[1:])
+	case "progress":
+		runProgress(ctx, client, args[1:])
+	case "set":
+		runSet(ctx, client, args[1:])
+	case "advance":
+		runAdvance(ctx, client, args[1:])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: reading-client [-addr host:port] <add|remove|progress|set|advance> ...")
+	os.Exit(2)
+}
+
+func fail(err error) {
+	if st, ok := status.FromError(err); ok {
+		log.Fatalf("%s: %s", st.Code(), st.Message())
+	}
+	log.Fatal(err)
+}
+
+func runAdd(ctx context.Context, client reading.ReadingListClient, args []string) {
+	fs := flag.NewFlagSet("add", flag.ExitOnError)
+	isbn := fs.String("isbn", "", "book ISBN")
+	title := fs.String("title", "", "book title")
+	author := fs.String("author", "", "book author")
+	year := fs.Int("year", 0, "publication year")
+	pages := fs.Int("pages", 0, "number of pages")
+	fs.Parse(args)
+
+	_, err := client.AddCourse(ctx, &reading.Course{
+		Isbn:   *isbn,
+		Title:  *title,
+		Author: *author,
+		Year:   int32(*year),
+		Pages:  int32(*pages),
+	})
+	if err != nil {
+		fail(err)
+	}
+}
+
+func runRemove(ctx context.Context, client reading.ReadingListClient, args []string) {
+	fs := flag.NewFlagSet("remove", flag.ExitOnError)
+	isbn := fs.String("isbn", "", "book ISBN")
+	fs.Parse(args)
+
+	if _, err := client.RemoveCourse(ctx, &reading.ISBN{Isbn: *isbn}); err != nil {
+		fail(err)
+	}
+}
+
+func runProgress(ctx context.Context, client reading.ReadingListClient, args []string) {
+	fs := flag.NewFlagSet("progress", flag.ExitOnError)
+	isbn := fs.String("isbn", "", "book ISBN")
+	fs.Parse(args)
+
+	p, err := client.GetProgress(ctx, &reading.ISBN{Isbn: *isbn})
+	if err != nil {
+		fail(err)
+	}
+	fmt.Println(p.GetPages())
+}
+
+func runSet(ctx context.Context, client reading.ReadingListClient, args []string) {
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+	isbn := fs.String("isbn", "", "book ISBN")
+	pages := fs.Int("pages", 0, "pages read so far")
+	fs.Parse(args)
+
+	if _, err := client.SetProgress(ctx, &reading.Progress{Isbn: *isbn, Pages: int32(*pages)}); err != nil {
+		fail(err)
+	}
+}
+
+func runAdvance(ctx context.Context, client reading.ReadingListClient, args []string) {
+	fs := flag.NewFlagSet("advance", flag.ExitOnError)
+	isbn := fs.String("isbn", "", "book ISBN")
+	pages := fs.Int("pages", 0, "pages to advance by")
+	fs.Parse(args)
+
+	if _, err := client.AdvanceProgress(ctx, &reading.Progress{Isbn: *isbn, Pages: int32(*pages)}); err != nil {
+		fail(err)
+	}
+}
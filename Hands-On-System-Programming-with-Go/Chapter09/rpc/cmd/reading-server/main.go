@@ -0,0 +1,31 @@
+// Command reading-server hosts the reading.ReadingList gRPC service.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/ImagineDevOps/Hands-On-System-Programming-with-Go/Chapter09/rpc/grpcserver"
+	"github.com/ImagineDevOps/Hands-On-System-Programming-with-Go/Chapter09/rpc/reading"
+)
+
+func main() {
+	addr := flag.String("addr", ":4200", "address to listen on")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("listen: %v", err)
+	}
+
+	s := grpc.NewServer()
+	reading.RegisterReadingListServer(s, grpcserver.New())
+
+	log.Printf("reading-server listening on %s", *addr)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("serve: %v", err)
+	}
+}
@@ -1,3 +1,8 @@
+//go:build legacy_netrpc
+
+// Package common's net/rpc adapter is kept for one release behind the
+// legacy_netrpc build tag for clients that haven't migrated to the
+// reading gRPC service yet.
 package common
 
 func setSuccess(err error, b *bool) error {
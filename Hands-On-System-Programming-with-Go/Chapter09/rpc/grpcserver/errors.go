@@ -0,0 +1,29 @@
+package grpcserver
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ImagineDevOps/Hands-On-System-Programming-with-Go/Chapter09/rpc/common"
+)
+
+// mapError turns the sentinel errors common.ReadingList returns into
+// the google.rpc.Status codes gRPC clients can switch on, so a
+// polyglot client can tell ErrISBN apart from ErrMissing without
+// parsing error strings.
+func mapError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, common.ErrISBN):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, common.ErrDuplicate):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, common.ErrMissing):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,75 @@
+// Package grpcserver adapts common.ReadingList to the reading.ReadingList
+// gRPC service, replacing the common.ReadingService net/rpc adapter.
+package grpcserver
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ImagineDevOps/Hands-On-System-Programming-with-Go/Chapter09/rpc/common"
+	"github.com/ImagineDevOps/Hands-On-System-Programming-with-Go/Chapter09/rpc/reading"
+)
+
+// Server implements reading.ReadingListServer on top of a
+// common.ReadingList. Every call is serialized with a mutex, same as
+// the net/rpc adapter relied on net/rpc's own per-connection locking.
+type Server struct {
+	reading.UnimplementedReadingListServer
+
+	mu   sync.Mutex
+	list common.ReadingList
+}
+
+// New returns a Server with an empty reading list.
+func New() *Server {
+	return &Server{}
+}
+
+func (s *Server) AddCourse(_ context.Context, c *reading.Course) (*reading.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.list.AddCourse(common.Course{
+		ISBN:   c.GetIsbn(),
+		Title:  c.GetTitle(),
+		Author: c.GetAuthor(),
+		Year:   int(c.GetYear()),
+		Pages:  int(c.GetPages()),
+	})
+	return &reading.Empty{}, mapError(err)
+}
+
+func (s *Server) RemoveCourse(_ context.Context, isbn *reading.ISBN) (*reading.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.list.RemoveCourse(isbn.GetIsbn())
+	return &reading.Empty{}, mapError(err)
+}
+
+func (s *Server) GetProgress(_ context.Context, isbn *reading.ISBN) (*reading.Progress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pages, err := s.list.GetProgress(isbn.GetIsbn())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &reading.Progress{Isbn: isbn.GetIsbn(), Pages: int32(pages)}, nil
+}
+
+func (s *Server) SetProgress(_ context.Context, p *reading.Progress) (*reading.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.list.SetProgress(p.GetIsbn(), int(p.GetPages()))
+	return &reading.Empty{}, mapError(err)
+}
+
+func (s *Server) AdvanceProgress(_ context.Context, p *reading.Progress) (*reading.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.list.AdvanceProgress(p.GetIsbn(), int(p.GetPages()))
+	return &reading.Empty{}, mapError(err)
+}